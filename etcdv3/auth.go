@@ -0,0 +1,92 @@
+package etcdv3
+
+import (
+	"context"
+
+	"github.com/etcd-manage/etcdsdk/model"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// UserAdd 创建一个用户
+func (sdk *EtcdV3Sdk) UserAdd(user, password string) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.UserAdd(ctx, user, password)
+	return
+}
+
+// UserDelete 删除一个用户
+func (sdk *EtcdV3Sdk) UserDelete(user string) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.UserDelete(ctx, user)
+	return
+}
+
+// UserChangePassword 修改用户密码
+func (sdk *EtcdV3Sdk) UserChangePassword(user, newPassword string) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.UserChangePassword(ctx, user, newPassword)
+	return
+}
+
+// UserGrantRole 给用户授予角色
+func (sdk *EtcdV3Sdk) UserGrantRole(user, role string) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.UserGrantRole(ctx, user, role)
+	return
+}
+
+// RoleAdd 创建一个角色
+func (sdk *EtcdV3Sdk) RoleAdd(role string) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.RoleAdd(ctx, role)
+	return
+}
+
+// RoleDelete 删除一个角色
+func (sdk *EtcdV3Sdk) RoleDelete(role string) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.RoleDelete(ctx, role)
+	return
+}
+
+// RoleGrantPermission 给角色授予[key, rangeEnd)范围内的权限，rangeEnd为空表示仅key本身
+func (sdk *EtcdV3Sdk) RoleGrantPermission(role, key, rangeEnd string, permType model.PermType) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.RoleGrantPermission(ctx, role, key, rangeEnd, toClientPermType(permType))
+	return
+}
+
+// AuthEnable 开启鉴权，开启后所有操作都需要携带合法的用户名密码
+func (sdk *EtcdV3Sdk) AuthEnable() (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.AuthEnable(ctx)
+	return
+}
+
+// AuthDisable 关闭鉴权
+func (sdk *EtcdV3Sdk) AuthDisable() (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.AuthDisable(ctx)
+	return
+}
+
+// toClientPermType 将model.PermType转换为clientv3.PermissionType
+func toClientPermType(p model.PermType) clientv3.PermissionType {
+	switch p {
+	case model.PERM_READ:
+		return clientv3.PermRead
+	case model.PERM_WRITE:
+		return clientv3.PermWrite
+	default:
+		return clientv3.PermReadWrite
+	}
+}
@@ -0,0 +1,104 @@
+package etcdv3
+
+import (
+	"context"
+
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// Session 对concurrency.Session的封装，承载Mutex和Election，session过期或关闭时
+// 其上持有的锁和leader身份自动释放
+type Session struct {
+	s *concurrency.Session
+}
+
+// NewSession 创建一个ttl秒的session
+func (sdk *EtcdV3Sdk) NewSession(ttl int) (*Session, error) {
+	s, err := concurrency.NewSession(sdk.cli, concurrency.WithTTL(ttl))
+	if err != nil {
+		return nil, err
+	}
+	return &Session{s: s}, nil
+}
+
+// Close 关闭session，释放其上持有的所有锁和leader身份
+func (s *Session) Close() error {
+	return s.s.Close()
+}
+
+// Mutex 基于etcd的分布式互斥锁
+type Mutex struct {
+	m *concurrency.Mutex
+}
+
+// NewMutex 在prefix下创建一把分布式互斥锁
+func (sdk *EtcdV3Sdk) NewMutex(session *Session, prefix string) *Mutex {
+	return &Mutex{m: concurrency.NewMutex(session.s, prefix)}
+}
+
+// Lock 阻塞直到获得锁或ctx被取消
+func (m *Mutex) Lock(ctx context.Context) error {
+	return m.m.Lock(ctx)
+}
+
+// TryLock 尝试获取锁，锁已被他人持有时立即返回错误而不阻塞
+func (m *Mutex) TryLock(ctx context.Context) error {
+	return m.m.TryLock(ctx)
+}
+
+// Unlock 释放锁
+func (m *Mutex) Unlock(ctx context.Context) error {
+	return m.m.Unlock(ctx)
+}
+
+// Election 基于etcd的leader选举
+type Election struct {
+	e *concurrency.Election
+}
+
+// NewElection 在prefix下创建一个选举
+func (sdk *EtcdV3Sdk) NewElection(session *Session, prefix string) *Election {
+	return &Election{e: concurrency.NewElection(session.s, prefix)}
+}
+
+// Campaign 参选，阻塞直到当选leader或ctx被取消
+func (e *Election) Campaign(ctx context.Context, val string) error {
+	return e.e.Campaign(ctx, val)
+}
+
+// Resign 放弃leader身份，允许其他候选人当选
+func (e *Election) Resign(ctx context.Context) error {
+	return e.e.Resign(ctx)
+}
+
+// Leader 返回当前leader的值
+func (e *Election) Leader(ctx context.Context) (string, error) {
+	resp, err := e.e.Leader(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Kvs) == 0 {
+		return "", nil
+	}
+	return string(resp.Kvs[0].Value), nil
+}
+
+// Observe 持续观察leader变化，返回leader值的只读通道，ctx取消时通道关闭
+func (e *Election) Observe(ctx context.Context) <-chan string {
+	out := make(chan string, 1)
+	ch := e.e.Observe(ctx)
+	go func() {
+		defer close(out)
+		for resp := range ch {
+			if len(resp.Kvs) == 0 {
+				continue
+			}
+			select {
+			case out <- string(resp.Kvs[0].Value):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,86 @@
+package etcdv3
+
+import (
+	"context"
+
+	"github.com/etcd-manage/etcdsdk/model"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// Grant 申请一个TTL秒后过期的租约
+func (sdk *EtcdV3Sdk) Grant(ttl int64) (leaseID int64, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	resp, err := sdk.cli.Grant(ctx, ttl)
+	if err != nil {
+		return
+	}
+	leaseID = int64(resp.ID)
+	return
+}
+
+// PutWithLease 将path绑定到指定租约上，租约过期后key自动失效
+func (sdk *EtcdV3Sdk) PutWithLease(path string, data []byte, leaseID int64) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.Put(ctx, path, string(data), clientv3.WithLease(clientv3.LeaseID(leaseID)))
+	return
+}
+
+// KeepAlive 持续为leaseID续约，直到ctx被取消或租约失效
+func (sdk *EtcdV3Sdk) KeepAlive(ctx context.Context, leaseID int64) (<-chan *model.LeaseKeepAlive, error) {
+	respCh, err := sdk.cli.KeepAlive(ctx, clientv3.LeaseID(leaseID))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *model.LeaseKeepAlive, 16)
+	go func() {
+		defer close(out)
+		for resp := range respCh {
+			if resp == nil {
+				continue
+			}
+			select {
+			case out <- &model.LeaseKeepAlive{LeaseID: int64(resp.ID), TTL: resp.TTL}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// Revoke 主动撤销租约，绑定的key立即失效
+func (sdk *EtcdV3Sdk) Revoke(leaseID int64) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.Revoke(ctx, clientv3.LeaseID(leaseID))
+	return
+}
+
+// RegisterService 注册一个随租约过期自动失效的服务节点：
+// 申请租约、写入key，并在后台持续续约，直到返回的cancel被调用
+func (sdk *EtcdV3Sdk) RegisterService(path string, data []byte, ttl int64) (cancel context.CancelFunc, err error) {
+	leaseID, err := sdk.Grant(ttl)
+	if err != nil {
+		return nil, err
+	}
+	if err = sdk.PutWithLease(path, data, leaseID); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	keepAliveCh, err := sdk.KeepAlive(ctx, leaseID)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	go func() {
+		// 持续消费续约响应以保持租约存活；调用方无需关心每次续约的结果
+		for range keepAliveCh {
+		}
+	}()
+
+	return cancel, nil
+}
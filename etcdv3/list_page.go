@@ -0,0 +1,89 @@
+package etcdv3
+
+import (
+	"context"
+
+	"github.com/etcd-manage/etcdsdk/model"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// defaultPageLimit ListPage在ListOptions.Limit未指定时使用的单页大小
+const defaultPageLimit = 1000
+
+// ListPage 对path前缀下的key做服务端分页查询，避免List原先为每个key再发一次Get的N+1开销
+func (sdk *EtcdV3Sdk) ListPage(path string, opts model.ListOptions) (page *model.Page, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = defaultPageLimit
+	}
+
+	startKey := path
+	if opts.FromKey != "" {
+		startKey = opts.FromKey
+	}
+
+	getOpts := []clientv3.OpOption{
+		clientv3.WithRange(clientv3.GetPrefixRangeEnd(path)),
+		// 多取一条用于判断是否还有下一页，而不必额外发起一次请求
+		clientv3.WithLimit(limit + 1),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend),
+	}
+	if !opts.WithValues {
+		getOpts = append(getOpts, clientv3.WithKeysOnly())
+	}
+
+	resp, err := sdk.cli.Get(ctx, startKey, getOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	kvs := resp.Kvs
+	more := int64(len(kvs)) > limit
+	if more {
+		kvs = kvs[:limit]
+	}
+
+	nodes, err := sdk.ConvertToPath(path, kvs)
+	if err != nil {
+		return nil, err
+	}
+
+	page = &model.Page{Nodes: nodes, More: more}
+	if more {
+		page.NextKey = string(resp.Kvs[limit].Key)
+	}
+	return page, nil
+}
+
+// ListStream 按页遍历path前缀下的全部key并通过channel流式返回，
+// 调用方可通过取消ctx提前终止遍历
+func (sdk *EtcdV3Sdk) ListStream(ctx context.Context, path string) (<-chan *model.Node, error) {
+	out := make(chan *model.Node, 64)
+
+	go func() {
+		defer close(out)
+		opts := model.ListOptions{WithValues: true}
+		for {
+			page, err := sdk.ListPage(path, opts)
+			if err != nil {
+				return
+			}
+			for _, node := range page.Nodes {
+				select {
+				case out <- node:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if !page.More {
+				return
+			}
+			opts.FromKey = page.NextKey
+		}
+	}()
+
+	return out, nil
+}
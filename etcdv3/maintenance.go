@@ -0,0 +1,144 @@
+package etcdv3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/etcd-manage/etcdsdk/model"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// compactorBookkeepingKey 各sdk实例共享的自动压缩互斥标记，通过CAS避免同一时刻重复压缩
+const compactorBookkeepingKey = "/etcdsdk/_internal/auto_compactor"
+
+// autoCompactInterval 自动压缩的检查周期
+const autoCompactInterval = 5 * time.Minute
+
+// Compact 压缩rev之前的历史版本，physical为true时阻塞直到物理空间真正被回收
+func (sdk *EtcdV3Sdk) Compact(rev int64, physical bool) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	opts := make([]clientv3.CompactOption, 0, 1)
+	if physical {
+		opts = append(opts, clientv3.WithCompactPhysical())
+	}
+	_, err = sdk.cli.Compact(ctx, rev, opts...)
+	return
+}
+
+// Defragment 对指定endpoint做碎片整理，会短暂阻塞该节点的读写
+func (sdk *EtcdV3Sdk) Defragment(endpoint string) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.Defragment(ctx, endpoint)
+	return
+}
+
+// AlarmList 列出集群当前的告警
+func (sdk *EtcdV3Sdk) AlarmList() (alarms []*model.Alarm, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	resp, err := sdk.cli.AlarmList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range resp.Alarms {
+		alarms = append(alarms, &model.Alarm{
+			MemberID: a.MemberID,
+			Type:     a.Alarm.String(),
+		})
+	}
+	return
+}
+
+// AlarmDisarm 解除集群所有告警，常用于清理NOSPACE告警后恢复写入
+func (sdk *EtcdV3Sdk) AlarmDisarm() (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	_, err = sdk.cli.AlarmDisarm(ctx, &clientv3.AlarmMember{})
+	return
+}
+
+// HashKV 获取指定endpoint在rev处的KV哈希，用于跨节点一致性校验
+func (sdk *EtcdV3Sdk) HashKV(endpoint string, rev int64) (result *model.HashKVResult, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+	resp, err := sdk.cli.HashKV(ctx, endpoint, rev)
+	if err != nil {
+		return nil, err
+	}
+	result = &model.HashKVResult{
+		Hash:            resp.Hash,
+		CompactRevision: resp.CompactRevision,
+		Header:          resp.Header.Revision,
+	}
+	return
+}
+
+// StartAutoCompactor 启动一个后台协程，按autoCompactInterval周期检查是否需要执行自动压缩，
+// 通过对compactorBookkeepingKey做CAS，避免同一集群部署了多个sdk实例时重复压缩。
+// mode为COMPACT_MODE_PERIODIC时每次检查都压缩到header.Revision-retention；
+// mode为COMPACT_MODE_REVISION时只有header.Revision比上次的压缩点（bookkeeping key中记录的revision）
+// 至少多出retention才会压缩；由于压缩点本身就是上次的header.Revision-retention，
+// 只要revision自上次压缩后有增长该条件即成立，实际效果与COMPACT_MODE_PERIODIC相同
+func (sdk *EtcdV3Sdk) StartAutoCompactor(ctx context.Context, mode model.CompactionMode, retention int64) {
+	go func() {
+		ticker := time.NewTicker(autoCompactInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				sdk.tryAutoCompact(mode, retention)
+			}
+		}
+	}()
+}
+
+// tryAutoCompact 尝试执行一次自动压缩：读取当前revision，按mode算出压缩点，
+// 对bookkeeping key做CAS抢占后再真正Compact；抢占失败说明其它实例已经处理过本轮
+func (sdk *EtcdV3Sdk) tryAutoCompact(mode model.CompactionMode, retention int64) {
+	getCtx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	resp, err := sdk.cli.Get(getCtx, compactorBookkeepingKey)
+	cancel()
+	if err != nil {
+		log.Println("自动压缩读取bookkeeping key失败:", err)
+		return
+	}
+
+	var modRevision int64
+	var lastCompactRev int64
+	if len(resp.Kvs) > 0 {
+		modRevision = resp.Kvs[0].ModRevision
+		lastCompactRev, _ = strconv.ParseInt(string(resp.Kvs[0].Value), 10, 64)
+	}
+
+	if mode == model.COMPACT_MODE_REVISION && resp.Header.Revision-lastCompactRev < retention {
+		// 当前revision距上次压缩点不足retention，本轮不压缩
+		return
+	}
+	targetRev := resp.Header.Revision - retention
+	if targetRev <= 0 || targetRev <= lastCompactRev {
+		return
+	}
+
+	succeeded, err := sdk.Txn().
+		If(model.CmpModRevision(compactorBookkeepingKey, "=", modRevision)).
+		Then(model.OpPut(compactorBookkeepingKey, fmt.Sprint(targetRev))).
+		Commit()
+	if err != nil {
+		log.Println("自动压缩CAS失败:", err)
+		return
+	}
+	if !succeeded {
+		return
+	}
+
+	if err := sdk.Compact(targetRev, false); err != nil {
+		log.Println("自动压缩执行失败:", err)
+	}
+}
@@ -0,0 +1,85 @@
+package etcdv3
+
+import (
+	"context"
+	"io"
+
+	"github.com/etcd-manage/etcdsdk/model"
+	bolt "go.etcd.io/bbolt"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+// Snapshot 通过clientv3.Maintenance.Snapshot获取集群当前的一致性快照，
+// 将其完整写入w并返回写入的字节数，供上层做定时备份
+func (sdk *EtcdV3Sdk) Snapshot(ctx context.Context, w io.Writer) (written int64, err error) {
+	rc, err := sdk.cli.Snapshot(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+	return io.Copy(w, rc)
+}
+
+// revBytesLen是mvcc backend里一条普通记录的bucket key长度：
+// 8字节主revision + 1字节分隔符 + 8字节子revision
+const revBytesLen = 8 + 1 + 8
+
+// markedRevBytesLen是墓碑记录的bucket key长度：在revBytesLen后多追加1字节tombstone标记
+const markedRevBytesLen = revBytesLen + 1
+
+// markTombstone是追加在墓碑记录bucket key末尾的标记字节
+const markTombstone = byte('t')
+
+// isTombstone判断"key" bucket里的一条记录是否是删除墓碑：
+// 墓碑与普通记录的区别不在value里（两者都可能是空value），
+// 而在bucket key的长度和末尾标记字节上
+func isTombstone(bucketKey []byte) bool {
+	return len(bucketKey) == markedRevBytesLen && bucketKey[markedRevBytesLen-1] == markTombstone
+}
+
+// RestoreSnapshot 打开Snapshot生成的快照文件，读出其中全部key-value，
+// 逐条写回到一个可用集群的targetPrefix下。
+// 快照文件本质是etcd mvcc所使用的bolt数据库，用户key存放在"key" bucket中，
+// value是mvccpb.KeyValue的protobuf编码，其Key字段即原始用户key
+func (sdk *EtcdV3Sdk) RestoreSnapshot(snapshotFile, targetPrefix string) (restored int, err error) {
+	db, err := bolt.Open(snapshotFile, 0400, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	err = db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte("key"))
+		if bucket == nil {
+			return model.ERR_SNAPSHOT_NO_KEY_BUCKET
+		}
+		// bucket key是按main+sub revision编码的，bolt的ForEach按bucket key升序遍历，
+		// 也就是按revision从旧到新遍历，所以同一个用户key后出现的记录直接覆盖前面的
+		// 就能折叠出它最新的状态；遇到墓碑记录说明该key在那之后已被删除，从结果里移除
+		latest := make(map[string][]byte)
+		if walkErr := bucket.ForEach(func(k, v []byte) error {
+			var kv mvccpb.KeyValue
+			if unmarshalErr := kv.Unmarshal(v); unmarshalErr != nil {
+				return unmarshalErr
+			}
+			key := string(kv.Key)
+			if isTombstone(k) {
+				delete(latest, key)
+				return nil
+			}
+			latest[key] = kv.Value
+			return nil
+		}); walkErr != nil {
+			return walkErr
+		}
+		for key, value := range latest {
+			path := targetPrefix + key
+			if putErr := sdk.Put(path, value); putErr != nil {
+				return putErr
+			}
+			restored++
+		}
+		return nil
+	})
+	return restored, err
+}
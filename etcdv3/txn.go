@@ -0,0 +1,111 @@
+package etcdv3
+
+import (
+	"context"
+
+	"github.com/etcd-manage/etcdsdk/model"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// Txn 事务构建器，对应 If(...).Then(...).Else(...).Commit() 的链式调用
+type Txn struct {
+	sdk  *EtcdV3Sdk
+	cmps []model.Cmp
+	then []model.Op
+	els  []model.Op
+}
+
+// Txn 创建一个事务构建器
+func (sdk *EtcdV3Sdk) Txn() *Txn {
+	return &Txn{sdk: sdk}
+}
+
+// If 追加事务成立所需满足的比较条件
+func (t *Txn) If(cmps ...model.Cmp) *Txn {
+	t.cmps = append(t.cmps, cmps...)
+	return t
+}
+
+// Then 追加条件全部满足时执行的操作
+func (t *Txn) Then(ops ...model.Op) *Txn {
+	t.then = append(t.then, ops...)
+	return t
+}
+
+// Else 追加条件不满足时执行的操作
+func (t *Txn) Else(ops ...model.Op) *Txn {
+	t.els = append(t.els, ops...)
+	return t
+}
+
+// Commit 提交事务，返回If条件是否成立
+func (t *Txn) Commit() (succeeded bool, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+
+	cmps := make([]clientv3.Cmp, 0, len(t.cmps))
+	for _, c := range t.cmps {
+		cmp, cerr := toClientCmp(c)
+		if cerr != nil {
+			return false, cerr
+		}
+		cmps = append(cmps, cmp)
+	}
+
+	thenOps, err := toClientOps(t.then)
+	if err != nil {
+		return false, err
+	}
+	elseOps, err := toClientOps(t.els)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := t.sdk.cli.Txn(ctx).If(cmps...).Then(thenOps...).Else(elseOps...).Commit()
+	if err != nil {
+		return false, err
+	}
+	return resp.Succeeded, nil
+}
+
+// toClientCmp 将model.Cmp转换为clientv3.Cmp
+func toClientCmp(c model.Cmp) (clientv3.Cmp, error) {
+	switch c.Target {
+	case model.CMP_VERSION:
+		return clientv3.Compare(clientv3.Version(c.Key), c.Op, c.Value), nil
+	case model.CMP_VALUE:
+		return clientv3.Compare(clientv3.Value(c.Key), c.Op, c.Value), nil
+	case model.CMP_MOD_REVISION:
+		return clientv3.Compare(clientv3.ModRevision(c.Key), c.Op, c.Value), nil
+	case model.CMP_CREATE_REVISION:
+		return clientv3.Compare(clientv3.CreateRevision(c.Key), c.Op, c.Value), nil
+	default:
+		return clientv3.Cmp{}, model.ERR_UNKNOWN_CMP_TARGET
+	}
+}
+
+// toClientOps 将model.Op列表转换为clientv3.Op列表
+func toClientOps(ops []model.Op) ([]clientv3.Op, error) {
+	result := make([]clientv3.Op, 0, len(ops))
+	for _, op := range ops {
+		switch op.Type {
+		case model.OP_PUT:
+			result = append(result, clientv3.OpPut(op.Key, op.Value))
+		case model.OP_GET:
+			if op.Prefix {
+				result = append(result, clientv3.OpGet(op.Key, clientv3.WithPrefix()))
+			} else {
+				result = append(result, clientv3.OpGet(op.Key))
+			}
+		case model.OP_DELETE:
+			if op.Prefix {
+				result = append(result, clientv3.OpDelete(op.Key, clientv3.WithPrefix()))
+			} else {
+				result = append(result, clientv3.OpDelete(op.Key))
+			}
+		default:
+			return nil, model.ERR_UNKNOWN_OP_TYPE
+		}
+	}
+	return result, nil
+}
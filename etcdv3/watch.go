@@ -0,0 +1,133 @@
+package etcdv3
+
+import (
+	"context"
+	"log"
+
+	"github.com/etcd-manage/etcdsdk/model"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// Watch 监听path上的变化，支持递归前缀监听、从指定revision续传监听
+// 以及在监听的revision被compact时自动重新全量拉取并恢复监听
+func (sdk *EtcdV3Sdk) Watch(path string, opts ...model.WatchOption) (<-chan *model.WatchEvent, context.CancelFunc, error) {
+	cfg := &model.WatchConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	watchOpts := make([]clientv3.OpOption, 0, 2)
+	if cfg.Recursive {
+		watchOpts = append(watchOpts, clientv3.WithPrefix())
+	}
+	if cfg.AfterIndex > 0 {
+		watchOpts = append(watchOpts, clientv3.WithRev(cfg.AfterIndex+1))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan *model.WatchEvent, 16)
+
+	go sdk.watchLoop(ctx, path, cfg, watchOpts, events)
+
+	return events, cancel, nil
+}
+
+// watchLoop 驱动clientv3.Watch，遇到ErrCompacted时重新同步后继续监听
+func (sdk *EtcdV3Sdk) watchLoop(ctx context.Context, path string, cfg *model.WatchConfig, watchOpts []clientv3.OpOption, events chan *model.WatchEvent) {
+	defer close(events)
+
+	wc := sdk.cli.Watch(ctx, path, watchOpts...)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case resp, ok := <-wc:
+			if !ok {
+				return
+			}
+			if resp.Err() != nil {
+				if resp.CompactRevision > 0 {
+					rev, err := sdk.watchResync(ctx, path, cfg, events)
+					if err != nil {
+						log.Println("watch重新同步失败:", err)
+						return
+					}
+					resumeOpts := append(append([]clientv3.OpOption{}, watchOpts...), clientv3.WithRev(rev+1))
+					wc = sdk.cli.Watch(ctx, path, resumeOpts...)
+					continue
+				}
+				log.Println("watch错误:", resp.Err())
+				return
+			}
+			for _, ev := range resp.Events {
+				if !sdk.emitWatchEvent(ctx, cfg, events, ev) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// emitWatchEvent 将clientv3的事件转换为model.WatchEvent并按类型过滤后投递
+func (sdk *EtcdV3Sdk) emitWatchEvent(ctx context.Context, cfg *model.WatchConfig, events chan *model.WatchEvent, ev *clientv3.Event) bool {
+	evType := model.EVENT_PUT
+	if ev.Type == mvccpb.DELETE {
+		evType = model.EVENT_DELETE
+	}
+	if !watchWants(cfg, evType) {
+		return true
+	}
+	we := &model.WatchEvent{
+		Type: evType,
+		Path: string(ev.Kv.Key),
+		Rev:  ev.Kv.ModRevision,
+	}
+	if evType == model.EVENT_PUT {
+		we.Value = string(ev.Kv.Value)
+	}
+	select {
+	case events <- we:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// watchResync 在收到ErrCompacted后重新全量拉取当前数据，合成一条EVENT_RESYNC事件
+// 返回本次拉取所处的revision，供调用方从该revision之后继续监听
+func (sdk *EtcdV3Sdk) watchResync(ctx context.Context, path string, cfg *model.WatchConfig, events chan *model.WatchEvent) (rev int64, err error) {
+	getOpts := make([]clientv3.OpOption, 0, 1)
+	if cfg.Recursive {
+		getOpts = append(getOpts, clientv3.WithPrefix())
+	}
+	resp, err := sdk.cli.Get(ctx, path, getOpts...)
+	if err != nil {
+		return 0, err
+	}
+	if watchWants(cfg, model.EVENT_RESYNC) {
+		we := &model.WatchEvent{
+			Type: model.EVENT_RESYNC,
+			Path: path,
+			Rev:  resp.Header.Revision,
+		}
+		select {
+		case events <- we:
+		case <-ctx.Done():
+		}
+	}
+	return resp.Header.Revision, nil
+}
+
+// watchWants 判断当前事件类型是否在过滤范围内，未指定过滤条件时全部接收
+func watchWants(cfg *model.WatchConfig, t model.EventType) bool {
+	if len(cfg.EventTypes) == 0 {
+		return true
+	}
+	for _, want := range cfg.EventTypes {
+		if want == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+package model
+
+// PermType 角色权限类型
+type PermType int
+
+const (
+	// PERM_READ 只读权限
+	PERM_READ PermType = iota
+	// PERM_WRITE 只写权限
+	PERM_WRITE
+	// PERM_READWRITE 读写权限
+	PERM_READWRITE
+)
@@ -0,0 +1,9 @@
+package model
+
+// LeaseKeepAlive 租约续约响应
+type LeaseKeepAlive struct {
+	// LeaseID 租约ID
+	LeaseID int64
+	// TTL 服务端确认后的剩余存活秒数
+	TTL int64
+}
@@ -0,0 +1,29 @@
+package model
+
+// CompactionMode 自动压缩的触发方式
+type CompactionMode int
+
+const (
+	// COMPACT_MODE_PERIODIC 按固定时间间隔触发压缩
+	COMPACT_MODE_PERIODIC CompactionMode = iota
+	// COMPACT_MODE_REVISION 按累积的revision数量触发压缩
+	COMPACT_MODE_REVISION
+)
+
+// Alarm 集群告警
+type Alarm struct {
+	// MemberID 触发告警的成员ID
+	MemberID uint64
+	// Type 告警类型，如 NOSPACE
+	Type string
+}
+
+// HashKVResult HashKV的返回结果，用于跨节点一致性校验
+type HashKVResult struct {
+	// Hash 该endpoint在指定revision处的KV哈希值
+	Hash uint32
+	// CompactRevision 该endpoint当前的压缩revision
+	CompactRevision int64
+	// Header 响应所处的revision
+	Header int64
+}
@@ -0,0 +1,21 @@
+package model
+
+// ListOptions ListPage的分页参数
+type ListOptions struct {
+	// Limit 单页最多返回的key数量，<=0时使用默认值
+	Limit int64
+	// FromKey 从该key（含）开始按字典序继续拉取，首页留空，翻页时填入上一页Page.NextKey
+	FromKey string
+	// WithValues 是否在同一次Range请求中带上value；关闭时仅返回key，节点不带value
+	WithValues bool
+}
+
+// Page ListPage返回的一页数据
+type Page struct {
+	// Nodes 本页的节点
+	Nodes []*Node
+	// More 是否还有下一页
+	More bool
+	// NextKey More为true时，下一页ListOptions.FromKey应设置的值
+	NextKey string
+}
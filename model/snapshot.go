@@ -0,0 +1,6 @@
+package model
+
+import "errors"
+
+// ERR_SNAPSHOT_NO_KEY_BUCKET 快照文件中缺少mvcc使用的"key" bucket，说明文件已损坏或不是etcd快照
+var ERR_SNAPSHOT_NO_KEY_BUCKET = errors.New("快照文件缺少key bucket")
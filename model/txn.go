@@ -0,0 +1,95 @@
+package model
+
+import "errors"
+
+var (
+	// ERR_UNKNOWN_CMP_TARGET 未知的Cmp.Target
+	ERR_UNKNOWN_CMP_TARGET = errors.New("未知的比较目标类型")
+	// ERR_UNKNOWN_OP_TYPE 未知的Op.Type
+	ERR_UNKNOWN_OP_TYPE = errors.New("未知的事务操作类型")
+)
+
+// CmpTarget 事务条件比较的目标字段
+type CmpTarget int
+
+const (
+	// CMP_VERSION 比较key的version
+	CMP_VERSION CmpTarget = iota
+	// CMP_VALUE 比较key的value
+	CMP_VALUE
+	// CMP_MOD_REVISION 比较key的mod revision
+	CMP_MOD_REVISION
+	// CMP_CREATE_REVISION 比较key的create revision
+	CMP_CREATE_REVISION
+)
+
+// Cmp 事务If子句中的一个比较条件
+type Cmp struct {
+	// Target 比较目标字段
+	Target CmpTarget
+	// Key 参与比较的key
+	Key string
+	// Op 比较符，如 "=", "!=", ">", "<"
+	Op string
+	// Value 比较值，Target不同时类型不同（Version/Revision为int64，Value为string）
+	Value interface{}
+}
+
+// CmpVersion 构造一个比较key version的条件
+func CmpVersion(key, op string, version int64) Cmp {
+	return Cmp{Target: CMP_VERSION, Key: key, Op: op, Value: version}
+}
+
+// CmpValue 构造一个比较key value的条件
+func CmpValue(key, op, value string) Cmp {
+	return Cmp{Target: CMP_VALUE, Key: key, Op: op, Value: value}
+}
+
+// CmpModRevision 构造一个比较key mod revision的条件
+func CmpModRevision(key, op string, rev int64) Cmp {
+	return Cmp{Target: CMP_MOD_REVISION, Key: key, Op: op, Value: rev}
+}
+
+// CmpCreateRevision 构造一个比较key create revision的条件
+func CmpCreateRevision(key, op string, rev int64) Cmp {
+	return Cmp{Target: CMP_CREATE_REVISION, Key: key, Op: op, Value: rev}
+}
+
+// OpType 事务操作类型
+type OpType int
+
+const (
+	// OP_PUT 写入key
+	OP_PUT OpType = iota
+	// OP_GET 读取key
+	OP_GET
+	// OP_DELETE 删除key
+	OP_DELETE
+)
+
+// Op 事务Then/Else子句中的一个操作
+type Op struct {
+	// Type 操作类型
+	Type OpType
+	// Key 操作的key
+	Key string
+	// Value OP_PUT时写入的值
+	Value string
+	// Prefix OP_GET/OP_DELETE时是否按前缀匹配（即Range操作）
+	Prefix bool
+}
+
+// OpPut 构造一个写入操作
+func OpPut(key, value string) Op {
+	return Op{Type: OP_PUT, Key: key, Value: value}
+}
+
+// OpGet 构造一个读取操作，prefix为true时按前缀匹配整个子树
+func OpGet(key string, prefix bool) Op {
+	return Op{Type: OP_GET, Key: key, Prefix: prefix}
+}
+
+// OpDelete 构造一个删除操作，prefix为true时按前缀匹配整个子树
+func OpDelete(key string, prefix bool) Op {
+	return Op{Type: OP_DELETE, Key: key, Prefix: prefix}
+}
@@ -0,0 +1,59 @@
+package model
+
+// EventType 监听事件类型
+type EventType int
+
+const (
+	// EVENT_PUT key被创建或更新
+	EVENT_PUT EventType = iota
+	// EVENT_DELETE key被删除
+	EVENT_DELETE
+	// EVENT_RESYNC 因压缩(compact)导致历史revision失效，重新全量拉取后合成的事件
+	EVENT_RESYNC
+)
+
+// WatchEvent 监听事件
+type WatchEvent struct {
+	// Type 事件类型
+	Type EventType
+	// Path 触发事件的key；EVENT_RESYNC时为监听的path
+	Path string
+	// Value 事件发生时的值，EVENT_DELETE时为空
+	Value string
+	// Rev 该事件对应的mod revision；EVENT_RESYNC时为重新拉取时的header revision
+	Rev int64
+}
+
+// WatchConfig 监听参数
+type WatchConfig struct {
+	// Recursive 是否递归监听该path前缀下的所有key
+	Recursive bool
+	// AfterIndex 从指定revision之后开始监听，0表示从当前revision开始，用于断线续传
+	AfterIndex int64
+	// EventTypes 只关注指定类型的事件，为空表示全部关注
+	EventTypes []EventType
+}
+
+// WatchOption 设置监听参数
+type WatchOption func(*WatchConfig)
+
+// WithRecursive 递归监听path前缀下的所有key
+func WithRecursive() WatchOption {
+	return func(c *WatchConfig) {
+		c.Recursive = true
+	}
+}
+
+// WithAfterIndex 从指定revision之后开始监听
+func WithAfterIndex(rev int64) WatchOption {
+	return func(c *WatchConfig) {
+		c.AfterIndex = rev
+	}
+}
+
+// WithEventTypes 只监听指定类型的事件
+func WithEventTypes(types ...EventType) WatchOption {
+	return func(c *WatchConfig) {
+		c.EventTypes = types
+	}
+}